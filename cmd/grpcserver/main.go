@@ -0,0 +1,137 @@
+// Command grpcserver exposes the aggregator via gRPC/Protobuf, alongside the
+// existing JSON/Lambda handlers in the root package, for non-browser clients
+// that want a typed, streaming interface.
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/kisunji/jobsearchaggregator/jobs"
+	"github.com/kisunji/jobsearchaggregator/jobservice"
+	"github.com/kisunji/jobsearchaggregator/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultScrapeConcurrency mirrors the root package's scheduler default; see
+// main.go for the HTTP/Lambda equivalent. The scrape timeout is
+// jobs.DefaultScrapeTimeout.
+const defaultScrapeConcurrency = 2
+
+func main() {
+	filterEngine, err := jobservice.LoadFilterEngine(filterConfigPath())
+	if err != nil {
+		log.Printf("Falling back to an unfiltered FilterEngine: %v", err)
+		filterEngine = jobservice.NewFilterEngine()
+	}
+
+	scheduler := jobs.NewScheduler([]jobs.Source{
+		{Name: "Amazon", Search: jobservice.NewAmazonSearch(filterEngine), Interval: 15 * time.Minute},
+		{Name: "League", Search: jobservice.NewLeagueSearch(filterEngine), Interval: 30 * time.Minute},
+		{Name: "Shopify", Search: jobservice.NewShopifySearch(filterEngine), Interval: time.Hour},
+	}, defaultScrapeConcurrency, jobs.DefaultScrapeTimeout)
+	scheduler.Start(context.Background())
+
+	server := &jobSearchServer{
+		aggregator: jobservice.NewAggregator(scheduler, jobservice.NewMemoryStore()),
+		scheduler:  scheduler,
+	}
+
+	addr, ok := os.LookupEnv("GRPC_ADDR")
+	if !ok {
+		addr = ":9090"
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", addr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterJobSearchServiceServer(grpcServer, server)
+
+	log.Printf("Running gRPC server: %s", addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("gRPC server stopped: %v", err)
+	}
+}
+
+func filterConfigPath() string {
+	if path, ok := os.LookupEnv("FILTER_CONFIG_PATH"); ok {
+		return path
+	}
+	return "filters.json"
+}
+
+// jobSearchServer implements pb.JobSearchServiceServer on top of the same
+// jobservice.Aggregator and jobs.Scheduler the HTTP/Lambda transport uses.
+type jobSearchServer struct {
+	pb.UnimplementedJobSearchServiceServer
+	aggregator *jobservice.Aggregator
+	scheduler  *jobs.Scheduler
+}
+
+// ListJobs returns the current snapshot; persisting it to the store happens
+// in the background, so a slow or unreachable store can't hold up the RPC.
+func (s *jobSearchServer) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	jobArray := s.aggregator.Jobs(ctx, req.GetForceRefresh())
+	return &pb.ListJobsResponse{Jobs: toProtoJobs(jobArray)}, nil
+}
+
+// StreamJobs streams each source's jobs grouped by source. When force_refresh
+// is set, it sends each source's batch as soon as that source's scrape
+// completes rather than waiting for the slowest one.
+func (s *jobSearchServer) StreamJobs(req *pb.StreamJobsRequest, stream pb.JobSearchService_StreamJobsServer) error {
+	if !req.GetForceRefresh() {
+		for _, record := range s.scheduler.Statuses() {
+			if err := stream.Send(&pb.JobBatch{Source: record.Source, Jobs: toProtoJobs(record.Jobs)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for record := range s.scheduler.RunAllAsync() {
+		if err := stream.Send(&pb.JobBatch{Source: record.Source, Jobs: toProtoJobs(record.Jobs)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *jobSearchServer) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.Job, error) {
+	results, err := s.aggregator.Store.Search(ctx, jobservice.Query{Company: req.GetCompany()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "search store: %v", err)
+	}
+	for _, j := range results {
+		if j.URL == req.GetUrl() {
+			return toProtoJob(j), nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "job not found")
+}
+
+func toProtoJobs(jobArray []jobservice.Job) []*pb.Job {
+	out := make([]*pb.Job, 0, len(jobArray))
+	for _, j := range jobArray {
+		out = append(out, toProtoJob(j))
+	}
+	return out
+}
+
+func toProtoJob(j jobservice.Job) *pb.Job {
+	return &pb.Job{
+		Company:                 j.Company,
+		Title:                   j.Title,
+		Qualifications:          j.Qualifications,
+		PreferredQualifications: j.PreferredQualifications,
+		Description:             j.Description,
+		Url:                     j.URL,
+		Score:                   int32(j.Score),
+	}
+}
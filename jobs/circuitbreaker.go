@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker trips after consecutive failures and, while open, rejects
+// runs for cooldown so a single slow/broken source can't keep stalling the
+// scheduler with retries.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// Open reports whether the breaker is currently tripped.
+func (c *circuitBreaker) Open() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return !c.openUntil.IsZero() && time.Now().Before(c.openUntil)
+}
+
+// RecordSuccess resets the breaker.
+func (c *circuitBreaker) RecordSuccess() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures = 0
+	c.openUntil = time.Time{}
+}
+
+// RecordFailure counts a failure, tripping the breaker once threshold is reached.
+func (c *circuitBreaker) RecordFailure() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures++
+	if c.failures >= c.threshold {
+		c.openUntil = time.Now().Add(c.cooldown)
+	}
+}
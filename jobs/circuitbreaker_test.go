@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTripsAtThreshold(t *testing.T) {
+	b := newCircuitBreaker(3, time.Minute)
+
+	if b.Open() {
+		t.Fatal("new breaker should not be open")
+	}
+
+	b.RecordFailure()
+	b.RecordFailure()
+	if b.Open() {
+		t.Fatal("breaker should not trip before reaching threshold")
+	}
+
+	b.RecordFailure()
+	if !b.Open() {
+		t.Fatal("breaker should trip once failures reach threshold")
+	}
+}
+
+func TestCircuitBreakerRecordSuccessResets(t *testing.T) {
+	b := newCircuitBreaker(2, time.Minute)
+
+	b.RecordFailure()
+	b.RecordSuccess()
+	b.RecordFailure()
+	if b.Open() {
+		t.Fatal("a success should reset the failure count, so one more failure shouldn't trip it")
+	}
+}
+
+func TestCircuitBreakerClosesAfterCooldown(t *testing.T) {
+	b := newCircuitBreaker(1, time.Millisecond)
+
+	b.RecordFailure()
+	if !b.Open() {
+		t.Fatal("breaker should be open immediately after tripping")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if b.Open() {
+		t.Fatal("breaker should close again once cooldown elapses")
+	}
+}
@@ -0,0 +1,222 @@
+// Package jobs schedules and runs the aggregator's scrapers in the
+// background, decoupled from the request path, so a handler can always
+// serve the last-successful snapshot immediately instead of blocking on
+// upstream calls.
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/kisunji/jobsearchaggregator/jobservice"
+)
+
+// breakerThreshold and breakerCooldown govern when a source's circuit trips
+// and how long it stays open before the scheduler tries it again.
+const (
+	breakerThreshold = 3
+	breakerCooldown  = 5 * time.Minute
+)
+
+// DefaultScrapeTimeout bounds how long a single source run, including
+// retries, is allowed to take before it's treated as a failure.
+const DefaultScrapeTimeout = 30 * time.Second
+
+// Source is one JobSearch and the cadence the Scheduler should run it on.
+type Source struct {
+	Name     string
+	Search   jobservice.JobSearch
+	Interval time.Duration
+}
+
+// Scheduler runs each Source on its own cadence via a bounded Worker pool,
+// keeping the last-successful result and status of every source so the
+// aggregator can answer requests without waiting on a live scrape.
+type Scheduler struct {
+	sources       []Source
+	worker        *Worker
+	breakers      map[string]*circuitBreaker
+	scrapeTimeout time.Duration
+	rootCtx       context.Context
+
+	mu      sync.RWMutex
+	records map[string]Record
+}
+
+// NewScheduler returns a Scheduler for sources, running at most concurrency
+// scrapes at once and giving each run up to scrapeTimeout to complete.
+func NewScheduler(sources []Source, concurrency int, scrapeTimeout time.Duration) *Scheduler {
+	s := &Scheduler{
+		sources:       sources,
+		worker:        NewWorker(concurrency),
+		breakers:      make(map[string]*circuitBreaker, len(sources)),
+		scrapeTimeout: scrapeTimeout,
+		rootCtx:       context.Background(),
+		records:       make(map[string]Record, len(sources)),
+	}
+	for _, src := range sources {
+		s.breakers[src.Name] = newCircuitBreaker(breakerThreshold, breakerCooldown)
+		s.records[src.Name] = Record{Source: src.Name, Status: StatusPending}
+	}
+	return s
+}
+
+// Start launches one background loop per source that runs it immediately
+// and then on its configured Interval, until ctx is done. ctx also becomes
+// the root every scrape (scheduled or forced) runs on, so that a
+// request-scoped context passed to RunAll later can't cancel a scrape out
+// from under other concurrent callers.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.rootCtx = ctx
+	for _, src := range s.sources {
+		go s.loop(src)
+	}
+}
+
+func (s *Scheduler) loop(src Source) {
+	s.runOnce(src)
+
+	ticker := time.NewTicker(src.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.rootCtx.Done():
+			return
+		case <-ticker.C:
+			s.runOnce(src)
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(src Source) {
+	breaker := s.breakers[src.Name]
+	if breaker.Open() {
+		log.Printf("jobs: circuit open for %s, skipping run", src.Name)
+		return
+	}
+
+	runCtx, cancel := context.WithTimeout(s.rootCtx, s.scrapeTimeout)
+	defer cancel()
+
+	s.setRunning(src.Name)
+	jobArray, err := s.worker.Run(runCtx, src)
+	if err != nil {
+		breaker.RecordFailure()
+		s.setError(src.Name, err)
+		return
+	}
+	breaker.RecordSuccess()
+	s.setComplete(src.Name, jobArray)
+}
+
+// RunAll triggers an immediate run of every source, bypassing their
+// schedules, and blocks until every source has reported in or ctx is done,
+// whichever comes first. The scrapes themselves always run on the
+// scheduler's own rootCtx, not ctx, so one caller's context expiring mid
+// forced-refresh can't cancel every source's run and trip every circuit
+// breaker at once for every other caller; it can only make this particular
+// call return early.
+func (s *Scheduler) RunAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, src := range s.sources {
+		wg.Add(1)
+		go func(src Source) {
+			defer wg.Done()
+			s.runOnce(src)
+		}(src)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// RunAllAsync triggers an immediate run of every source, bypassing their
+// schedules, and returns a channel that receives each source's Record as
+// soon as its run completes (in completion order, not source order). The
+// channel is closed once every source has reported in.
+func (s *Scheduler) RunAllAsync() <-chan Record {
+	out := make(chan Record, len(s.sources))
+	var wg sync.WaitGroup
+	for _, src := range s.sources {
+		wg.Add(1)
+		go func(src Source) {
+			defer wg.Done()
+			s.runOnce(src)
+			out <- s.recordFor(src.Name)
+		}(src)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+func (s *Scheduler) recordFor(name string) Record {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.records[name]
+}
+
+// Snapshot returns the last-successful jobs from every source, aggregated.
+func (s *Scheduler) Snapshot() []jobservice.Job {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var all []jobservice.Job
+	for _, src := range s.sources {
+		all = append(all, s.records[src.Name].Jobs...)
+	}
+	return all
+}
+
+// Statuses returns the current Record for every source, in source order.
+func (s *Scheduler) Statuses() []Record {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := make([]Record, 0, len(s.sources))
+	for _, src := range s.sources {
+		records = append(records, s.records[src.Name])
+	}
+	return records
+}
+
+func (s *Scheduler) setRunning(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.records[name]
+	r.Status = StatusRunning
+	s.records[name] = r
+}
+
+func (s *Scheduler) setError(name string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.records[name]
+	r.Status = StatusError
+	r.LastRun = time.Now()
+	r.LastError = err.Error()
+	s.records[name] = r
+}
+
+func (s *Scheduler) setComplete(name string, jobArray []jobservice.Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.records[name]
+	r.Status = StatusComplete
+	r.LastRun = time.Now()
+	r.LastError = ""
+	r.Jobs = jobArray
+	s.records[name] = r
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/kisunji/jobsearchaggregator/jobservice"
+)
+
+// Status is the lifecycle state of a source's most recent scrape attempt.
+type Status string
+
+// The possible values of Status.
+const (
+	StatusPending  Status = "PENDING"
+	StatusRunning  Status = "RUNNING"
+	StatusComplete Status = "COMPLETE"
+	StatusError    Status = "ERROR"
+)
+
+// Record is the latest known state of one source, including the jobs it
+// produced the last time it completed successfully.
+type Record struct {
+	Source    string
+	Status    Status
+	LastRun   time.Time
+	LastError string
+	Jobs      []jobservice.Job
+}
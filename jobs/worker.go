@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kisunji/jobsearchaggregator/jobservice"
+)
+
+// initialBackoff and maxAttempts govern the retry behaviour Worker applies
+// to a failing source before giving up on that run.
+const (
+	initialBackoff = 500 * time.Millisecond
+	maxAttempts    = 3
+)
+
+// Worker runs a single Source's scrape, bounded by a shared concurrency
+// limit, retrying with exponential backoff on failure.
+type Worker struct {
+	sem chan struct{}
+}
+
+// NewWorker returns a Worker that runs at most concurrency scrapes at once.
+func NewWorker(concurrency int) *Worker {
+	return &Worker{sem: make(chan struct{}, concurrency)}
+}
+
+// Run executes src.Search.Jobs(), making up to maxAttempts attempts in all,
+// with exponential backoff between them, if it fails. It blocks until a concurrency slot is free.
+func (w *Worker) Run(ctx context.Context, src Source) ([]jobservice.Job, error) {
+	select {
+	case w.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-w.sem }()
+
+	backoff := initialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		jobs, err := runSource(ctx, src)
+		if err == nil {
+			return jobs, nil
+		}
+		lastErr = err
+		log.Printf("jobs: %s attempt %d/%d failed: %v", src.Name, attempt, maxAttempts, err)
+
+		if attempt == maxAttempts {
+			break
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		backoff *= 2
+	}
+	return nil, lastErr
+}
+
+// runSource calls src.Search.Jobs(ctx), additionally converting a panic into
+// an error so a single bad response can be retried instead of crashing the worker.
+func runSource(ctx context.Context, src Source) (jobs []jobservice.Job, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("jobs: %s panicked: %v", src.Name, r)
+		}
+	}()
+	return src.Search.Jobs(ctx)
+}
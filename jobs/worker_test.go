@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kisunji/jobsearchaggregator/jobservice"
+)
+
+// fakeSearch implements jobservice.JobSearch. Its i-th call panics if
+// panics[i] is set, else errs if fail[i] is set, else returns results.
+type fakeSearch struct {
+	calls   int
+	fail    []bool
+	panics  []bool
+	results []jobservice.Job
+}
+
+func (f *fakeSearch) Jobs(ctx context.Context) ([]jobservice.Job, error) {
+	i := f.calls
+	f.calls++
+	if i < len(f.panics) && f.panics[i] {
+		panic("boom")
+	}
+	if i < len(f.fail) && f.fail[i] {
+		return nil, errors.New("scrape failed")
+	}
+	return f.results, nil
+}
+
+func TestWorkerRetriesOnFailureThenSucceeds(t *testing.T) {
+	search := &fakeSearch{fail: []bool{true, true, false}, results: []jobservice.Job{{Title: "Engineer"}}}
+	w := NewWorker(1)
+
+	jobs, err := w.Run(context.Background(), Source{Name: "Test", Search: search})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if len(jobs) != 1 || jobs[0].Title != "Engineer" {
+		t.Fatalf("unexpected jobs: %+v", jobs)
+	}
+	if search.calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", search.calls)
+	}
+}
+
+func TestWorkerGivesUpAfterMaxAttempts(t *testing.T) {
+	search := &fakeSearch{fail: []bool{true, true, true}}
+	w := NewWorker(1)
+
+	_, err := w.Run(context.Background(), Source{Name: "Test", Search: search})
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries")
+	}
+	if search.calls != maxAttempts {
+		t.Fatalf("expected %d attempts, got %d", maxAttempts, search.calls)
+	}
+}
+
+func TestWorkerRecoversPanic(t *testing.T) {
+	search := &fakeSearch{panics: []bool{true, true, true}}
+	w := NewWorker(1)
+
+	_, err := w.Run(context.Background(), Source{Name: "Test", Search: search})
+	if err == nil {
+		t.Fatal("expected a panic to surface as an error")
+	}
+}
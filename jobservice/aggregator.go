@@ -0,0 +1,55 @@
+package jobservice
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// storeWriteTimeout bounds how long an async Store.Upsert is allowed to run
+// after Aggregator.Jobs has already returned its snapshot to the caller.
+const storeWriteTimeout = 10 * time.Second
+
+// Snapshotter provides the last-successful aggregated jobs without blocking
+// on a live scrape, and a way to force an immediate refresh. jobs.Scheduler
+// implements this.
+type Snapshotter interface {
+	Snapshot() []Job
+	RunAll(ctx context.Context)
+}
+
+// Aggregator is the shared entry point the HTTP/Lambda and gRPC transports
+// both use to fetch the current aggregate job list, keeping Store in sync
+// with whatever the Snapshotter last produced.
+type Aggregator struct {
+	Snapshots Snapshotter
+	Store     Store
+}
+
+// NewAggregator returns an Aggregator backed by snapshots and store.
+func NewAggregator(snapshots Snapshotter, store Store) *Aggregator {
+	return &Aggregator{Snapshots: snapshots, Store: store}
+}
+
+// Jobs returns the current aggregate job list, forcing an immediate refresh
+// across every source first if forceRefresh is set. The result is upserted
+// into Store in the background, on a detached, timeout-bounded context, so a
+// slow or unreachable Store can't hold up the response: a caller's deadline
+// governs the snapshot, not the history write.
+func (a *Aggregator) Jobs(ctx context.Context, forceRefresh bool) []Job {
+	if forceRefresh {
+		a.Snapshots.RunAll(ctx)
+	}
+
+	jobArray := a.Snapshots.Snapshot()
+	go a.upsertAsync(jobArray)
+	return jobArray
+}
+
+func (a *Aggregator) upsertAsync(jobArray []Job) {
+	ctx, cancel := context.WithTimeout(context.Background(), storeWriteTimeout)
+	defer cancel()
+	if err := a.Store.Upsert(ctx, jobArray); err != nil {
+		log.Printf("jobservice: failed to upsert jobs into store: %v", err)
+	}
+}
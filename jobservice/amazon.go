@@ -1,26 +1,33 @@
 package jobservice
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // AmazonSearch holds the search URL and base URL
 type AmazonSearch struct {
 	SearchURL string
 	BaseURL   string
+	Filter    *FilterEngine
 }
 
-// NewAmazonSearch returns the default AmazonSearch
-func NewAmazonSearch() *AmazonSearch {
+// NewAmazonSearch returns the default AmazonSearch, filtering and scoring
+// postings using filter's "Amazon" FilterConfig, if one is configured.
+func NewAmazonSearch(filter *FilterEngine) *AmazonSearch {
 	return &AmazonSearch{
 		SearchURL: "https://www.amazon.jobs/en/search.json?base_query=&category[]=software-development&job_function_id[]=job_function_corporate_80rdb4&=&normalized_location[]=Toronto,+Ontario,+CAN&offset=0&query_options=&radius=24km&region=&result_limit=200&sort=recent",
 		BaseURL:   "https://www.amazon.jobs",
+		Filter:    filter,
 	}
 }
 
@@ -41,13 +48,22 @@ type amazonJobList struct {
 	Jobs []amazonJob
 }
 
-// Jobs calls Amazon's job search API and applies custom filters to show only relevant job postings
-func (a *AmazonSearch) Jobs() []Job {
+// Jobs calls Amazon's job search API and applies the configured FilterEngine
+// to show only relevant job postings. It returns an error, rather than
+// crashing the process, if Amazon returns malformed JSON or a status of 400
+// or above.
+func (a *AmazonSearch) Jobs(ctx context.Context) ([]Job, error) {
 	var jobArray []Job
-	responseBody := a.callAPI(a.SearchURL)
-	jobList := a.convertToJSONList(responseBody)
+	responseBody, err := a.callAPI(ctx, a.SearchURL)
+	if err != nil {
+		return nil, err
+	}
+	jobList, err := a.convertToJSONList(responseBody)
+	if err != nil {
+		return nil, err
+	}
 
-	suitableJobs := a.filter(jobList.Jobs, a.isRecent, a.isSuitable)
+	suitableJobs := a.filter(jobList.Jobs, a.isSuitable)
 
 	for _, v := range suitableJobs {
 		qualifications := a.processQualifications(v.Qualifications)
@@ -60,34 +76,46 @@ func (a *AmazonSearch) Jobs() []Job {
 			PreferredQualifications: prefQualifications,
 			Description:             v.Description,
 			URL:                     a.BaseURL + v.URL,
+			Score:                   a.Filter.Score("Amazon", v.Title, v.Qualifications),
 		})
 	}
-	return jobArray
+	return jobArray, nil
 }
 
-func (a *AmazonSearch) callAPI(url string) []byte {
-	resp, err := http.Get(url)
+func (a *AmazonSearch) callAPI(ctx context.Context, url string) ([]byte, error) {
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("amazon: build request for %s: %w", url, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		log.Fatal(err)
+		log.Printf("source=Amazon url=%s elapsed=%s error=%v", url, time.Since(start), err)
+		return nil, fmt.Errorf("amazon: call %s: %w", url, err)
 	}
 	defer resp.Body.Close()
+
 	body, err := ioutil.ReadAll(resp.Body)
+	log.Printf("source=Amazon url=%s status=%d elapsed=%s", url, resp.StatusCode, time.Since(start))
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("amazon: read response from %s: %w", url, err)
 	}
-	return body
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("amazon: %s returned status %d", url, resp.StatusCode)
+	}
+	return body, nil
 }
 
-func (a *AmazonSearch) convertToJSONList(bytes []byte) amazonJobList {
+func (a *AmazonSearch) convertToJSONList(bytes []byte) (amazonJobList, error) {
 	if !json.Valid(bytes) {
-		log.Fatal("Not a valid Json")
+		return amazonJobList{}, errors.New("amazon: response body is not valid JSON")
 	}
 	var jobList amazonJobList
-	err := json.Unmarshal(bytes, &jobList)
-	if err != nil {
-		log.Fatal(err)
+	if err := json.Unmarshal(bytes, &jobList); err != nil {
+		return amazonJobList{}, fmt.Errorf("amazon: unmarshal job list: %w", err)
 	}
-	return jobList
+	return jobList, nil
 }
 
 // filters based on any number of predicates
@@ -108,38 +136,28 @@ OUTER:
 }
 
 func (a *AmazonSearch) isSuitable(j amazonJob) bool {
-	// Positions containing these words are generally not suitable
-	if strings.Contains(j.Title, "Manager") ||
-		strings.Contains(j.Title, "Senior") ||
-		strings.Contains(j.Title, "Sr") ||
-		strings.Contains(j.Title, "II") {
-		return false
-	}
-
-	// If there is mention of numbers of years, keep it to 2 or less
-	re := regexp.MustCompile(`[3-9]\+? [Yy]ear`)
-	if re.MatchString(j.Qualifications) {
-		return false
-	}
-	return true
+	return a.Filter.IsSuitable("Amazon", j.Title, j.Qualifications, ageDaysSince(j.TimeSinceLastUpdated))
 }
 
-func (a *AmazonSearch) isRecent(j amazonJob) bool {
-	// Make sure job was updated within last 2 months
-	if strings.Contains(j.TimeSinceLastUpdated, "month") {
-		re := regexp.MustCompile(`[0-9]+`)
-		monthString := re.FindString(j.TimeSinceLastUpdated)
-		monthValue, err := strconv.Atoi(monthString)
-		if err != nil {
-			log.Fatal(err)
-		}
-		return monthValue <= 1
+// ageDaysSince converts Amazon's "updated_time" string (e.g. "2 months ago",
+// "5 days ago", "a year ago") into an approximate age in days.
+func ageDaysSince(updatedTime string) int {
+	re := regexp.MustCompile(`[0-9]+`)
+	numString := re.FindString(updatedTime)
+	num, err := strconv.Atoi(numString)
+	if err != nil {
+		// Strings like "a day ago" have no digit; treat as 1.
+		num = 1
 	}
-	// If posting contains the word "year", ignore it
-	if strings.Contains(j.TimeSinceLastUpdated, "year") {
-		return false
+
+	switch {
+	case strings.Contains(updatedTime, "year"):
+		return num * 365
+	case strings.Contains(updatedTime, "month"):
+		return num * 30
+	default:
+		return num
 	}
-	return true
 }
 
 func (a *AmazonSearch) processQualifications(rawQualifications string) []string {
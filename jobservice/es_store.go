@@ -0,0 +1,286 @@
+package jobservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+// esIndex is the single index jobs are stored in.
+const esIndex = "jobs"
+
+// esDoc is the document shape persisted to Elasticsearch. It embeds Job and
+// adds the bookkeeping Store.Since/Search/Deleted need, which Job itself has
+// no use for outside of persistence.
+type esDoc struct {
+	Job
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+	Deleted   bool      `json:"deleted"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// ESStore is an Elasticsearch-backed Store, used in production so job
+// history survives across deploys and Lambda cold starts.
+type ESStore struct {
+	client *elasticsearch.Client
+}
+
+// NewESStore returns an ESStore backed by client, querying/writing esIndex.
+func NewESStore(client *elasticsearch.Client) *ESStore {
+	return &ESStore{client: client}
+}
+
+func esDocID(j Job) string {
+	return j.Company + "::" + j.URL
+}
+
+// Upsert implements Store. For each company represented in jobs, any
+// existing, not-yet-deleted doc for that company whose key is absent from
+// jobs is reindexed with Deleted set, so Deleted can later report it.
+func (s *ESStore) Upsert(ctx context.Context, jobs []Job) error {
+	companies := make(map[string]bool)
+	seenIDs := make(map[string]bool, len(jobs))
+	for _, j := range jobs {
+		companies[j.Company] = true
+		seenIDs[esDocID(j)] = true
+	}
+	if err := s.markDeleted(ctx, companies, seenIDs); err != nil {
+		return err
+	}
+
+	for _, j := range jobs {
+		id := esDocID(j)
+		existing, err := s.getDoc(ctx, id)
+		if err != nil {
+			return fmt.Errorf("jobservice: lookup %s: %w", id, err)
+		}
+
+		now := time.Now()
+		doc := esDoc{Job: j, FirstSeen: now, LastSeen: now}
+		if existing != nil {
+			doc.FirstSeen = existing.FirstSeen
+		}
+
+		if err := s.indexDoc(ctx, id, doc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// markDeleted flags every stored, not-yet-deleted doc belonging to one of
+// companies as deleted if its ID isn't in seenIDs, i.e. it was absent from
+// the batch just Upserted for its company.
+func (s *ESStore) markDeleted(ctx context.Context, companies map[string]bool, seenIDs map[string]bool) error {
+	for company := range companies {
+		docs, err := s.query(ctx, map[string]interface{}{
+			"query": map[string]interface{}{
+				"bool": map[string]interface{}{
+					"must":     []map[string]interface{}{{"match": map[string]interface{}{"Company": company}}},
+					"must_not": []map[string]interface{}{{"term": map[string]interface{}{"deleted": true}}},
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("jobservice: query existing docs for %s: %w", company, err)
+		}
+		for _, j := range docs {
+			id := esDocID(j)
+			if seenIDs[id] {
+				continue
+			}
+			existing, err := s.getDoc(ctx, id)
+			if err != nil {
+				return fmt.Errorf("jobservice: lookup %s: %w", id, err)
+			}
+			if existing == nil || existing.Deleted {
+				continue
+			}
+			existing.Deleted = true
+			existing.DeletedAt = time.Now()
+			if err := s.indexDoc(ctx, id, *existing); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *ESStore) indexDoc(ctx context.Context, id string, doc esDoc) error {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("jobservice: marshal %s: %w", id, err)
+	}
+
+	req := esapi.IndexRequest{Index: esIndex, DocumentID: id, Body: bytes.NewReader(body)}
+	res, err := req.Do(ctx, s.client)
+	if err != nil {
+		return fmt.Errorf("jobservice: index %s: %w", id, err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("jobservice: elasticsearch returned %s indexing %s", res.Status(), id)
+	}
+	return nil
+}
+
+func (s *ESStore) getDoc(ctx context.Context, id string) (*esDoc, error) {
+	req := esapi.GetRequest{Index: esIndex, DocumentID: id}
+	res, err := req.Do(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("elasticsearch returned %s", res.Status())
+	}
+
+	var hit struct {
+		Source esDoc `json:"_source"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&hit); err != nil {
+		return nil, err
+	}
+	return &hit.Source, nil
+}
+
+// Search implements Store.
+func (s *ESStore) Search(ctx context.Context, q Query) ([]Job, error) {
+	return s.query(ctx, buildSearchQuery(q))
+}
+
+// Since implements Store.
+func (s *ESStore) Since(ctx context.Context, t time.Time) ([]Job, error) {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":     []map[string]interface{}{{"range": map[string]interface{}{"lastSeen": map[string]interface{}{"gte": t.Format(time.RFC3339)}}}},
+				"must_not": []map[string]interface{}{{"term": map[string]interface{}{"deleted": true}}},
+			},
+		},
+	}
+	return s.query(ctx, query)
+}
+
+// Deleted implements Store.
+func (s *ESStore) Deleted(ctx context.Context, t time.Time) ([]Job, error) {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": []map[string]interface{}{
+					{"term": map[string]interface{}{"deleted": true}},
+					{"range": map[string]interface{}{"deletedAt": map[string]interface{}{"gte": t.Format(time.RFC3339)}}},
+				},
+			},
+		},
+	}
+	return s.query(ctx, query)
+}
+
+// esPageSize bounds each page query fetches. Elasticsearch defaults to 10
+// hits per request, so without paging through size/from, any query matching
+// more than 10 docs (trivially reached once a single company has more than
+// 10 historical postings) would silently truncate.
+const esPageSize = 500
+
+// query runs query against esIndex, paging through every matching hit via
+// size/from rather than relying on Elasticsearch's default 10-hit cap.
+func (s *ESStore) query(ctx context.Context, query map[string]interface{}) ([]Job, error) {
+	var jobs []Job
+	for from := 0; ; from += esPageSize {
+		page, total, err := s.searchPage(ctx, query, from, esPageSize)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, page...)
+		if len(page) < esPageSize || len(jobs) >= total {
+			return jobs, nil
+		}
+	}
+}
+
+func (s *ESStore) searchPage(ctx context.Context, query map[string]interface{}, from, size int) ([]Job, int, error) {
+	body := make(map[string]interface{}, len(query)+2)
+	for k, v := range query {
+		body[k] = v
+	}
+	body["from"] = from
+	body["size"] = size
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, 0, fmt.Errorf("jobservice: encode query: %w", err)
+	}
+
+	res, err := s.client.Search(
+		s.client.Search.WithContext(ctx),
+		s.client.Search.WithIndex(esIndex),
+		s.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("jobservice: search: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, 0, fmt.Errorf("jobservice: elasticsearch returned %s searching", res.Status())
+	}
+
+	var parsed struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source esDoc `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, 0, fmt.Errorf("jobservice: decode search response: %w", err)
+	}
+
+	jobs := make([]Job, 0, len(parsed.Hits.Hits))
+	for _, h := range parsed.Hits.Hits {
+		jobs = append(jobs, h.Source.Job)
+	}
+	return jobs, parsed.Hits.Total.Value, nil
+}
+
+func buildSearchQuery(q Query) map[string]interface{} {
+	var must []map[string]interface{}
+	if q.Keyword != "" {
+		must = append(must, map[string]interface{}{"match": map[string]interface{}{"Title": q.Keyword}})
+	}
+	if q.Company != "" {
+		must = append(must, map[string]interface{}{"match": map[string]interface{}{"Company": q.Company}})
+	}
+	if q.QualificationsContains != "" {
+		must = append(must, map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":  q.QualificationsContains,
+				"fields": []string{"Qualifications", "PreferredQualifications"},
+			},
+		})
+	}
+	if !q.PostedAfter.IsZero() {
+		must = append(must, map[string]interface{}{
+			"range": map[string]interface{}{
+				"firstSeen": map[string]interface{}{"gte": q.PostedAfter.Format(time.RFC3339)},
+			},
+		})
+	}
+	if must == nil {
+		must = []map[string]interface{}{}
+	}
+	mustNot := []map[string]interface{}{{"term": map[string]interface{}{"deleted": true}}}
+	return map[string]interface{}{"query": map[string]interface{}{"bool": map[string]interface{}{"must": must, "must_not": mustNot}}}
+}
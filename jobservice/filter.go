@@ -0,0 +1,108 @@
+package jobservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+// FilterConfig declares, per company, the relevance rules a job posting must
+// satisfy and the keywords used to score the ones that pass. It's the
+// user-tunable replacement for the filter logic that used to be hardcoded in
+// each JobSearch implementation.
+type FilterConfig struct {
+	Company            string   `json:"company"`
+	TitleExcludes      []string `json:"title_excludes"`
+	MaxYearsExperience int      `json:"max_years_experience"`
+	MaxAgeDays         int      `json:"max_age_days"`
+	RequiredKeywords   []string `json:"required_keywords"`
+}
+
+// FilterEngine applies a FilterConfig, loaded from JSON at startup, on behalf
+// of any JobSearch implementation. A company with no matching config is left
+// unfiltered and scores 0.
+type FilterEngine struct {
+	configs map[string]FilterConfig
+}
+
+// NewFilterEngine returns a FilterEngine with no configs. Use LoadFilterEngine
+// to populate one from a file.
+func NewFilterEngine() *FilterEngine {
+	return &FilterEngine{configs: make(map[string]FilterConfig)}
+}
+
+// LoadFilterEngine reads a JSON array of FilterConfig from path and indexes it by Company.
+func LoadFilterEngine(path string) (*FilterEngine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("jobservice: read filter config %s: %w", path, err)
+	}
+	var configs []FilterConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("jobservice: parse filter config %s: %w", path, err)
+	}
+
+	engine := NewFilterEngine()
+	for _, c := range configs {
+		engine.configs[c.Company] = c
+	}
+	return engine, nil
+}
+
+// IsSuitable reports whether a posting from company, with the given title,
+// qualifications text, and age in days, passes that company's FilterConfig.
+// Companies with no config pass everything.
+func (f *FilterEngine) IsSuitable(company, title, qualifications string, ageDays int) bool {
+	cfg, ok := f.configs[company]
+	if !ok {
+		return true
+	}
+
+	for _, excl := range cfg.TitleExcludes {
+		if strings.Contains(title, excl) {
+			return false
+		}
+	}
+
+	if cfg.MaxYearsExperience > 0 {
+		re := regexp.MustCompile(fmt.Sprintf(`[%d-9]\+? [Yy]ear`, cfg.MaxYearsExperience+1))
+		if re.MatchString(qualifications) {
+			return false
+		}
+	}
+
+	if cfg.MaxAgeDays > 0 && ageDays > cfg.MaxAgeDays {
+		return false
+	}
+
+	for _, kw := range cfg.RequiredKeywords {
+		if !strings.Contains(title, kw) && !strings.Contains(qualifications, kw) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Score ranks a posting from company for the given title/qualifications text,
+// rewarding required keywords found in the title more than ones only found in
+// the qualifications. Companies with no config always score 0.
+func (f *FilterEngine) Score(company, title, qualifications string) int {
+	cfg, ok := f.configs[company]
+	if !ok {
+		return 0
+	}
+
+	score := 0
+	for _, kw := range cfg.RequiredKeywords {
+		if strings.Contains(title, kw) {
+			score += 2
+		}
+		if strings.Contains(qualifications, kw) {
+			score++
+		}
+	}
+	return score
+}
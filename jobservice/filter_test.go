@@ -0,0 +1,71 @@
+package jobservice
+
+import "testing"
+
+func engineWithConfig(cfg FilterConfig) *FilterEngine {
+	e := NewFilterEngine()
+	e.configs[cfg.Company] = cfg
+	return e
+}
+
+func TestFilterEngineIsSuitableUnconfiguredCompanyPassesEverything(t *testing.T) {
+	e := NewFilterEngine()
+	if !e.IsSuitable("Acme", "Senior Manager", "10+ years", 999) {
+		t.Fatal("a company with no config should pass everything")
+	}
+}
+
+func TestFilterEngineIsSuitableTitleExcludes(t *testing.T) {
+	e := engineWithConfig(FilterConfig{Company: "Acme", TitleExcludes: []string{"Manager", "Senior"}})
+
+	if e.IsSuitable("Acme", "Senior Engineer", "", 0) {
+		t.Fatal("a title containing an excluded term should be rejected")
+	}
+	if !e.IsSuitable("Acme", "Software Engineer", "", 0) {
+		t.Fatal("a title without any excluded term should pass")
+	}
+}
+
+func TestFilterEngineIsSuitableMaxYearsExperience(t *testing.T) {
+	e := engineWithConfig(FilterConfig{Company: "Acme", MaxYearsExperience: 2})
+
+	if e.IsSuitable("Acme", "Engineer", "5+ years of experience", 0) {
+		t.Fatal("qualifications exceeding MaxYearsExperience should be rejected")
+	}
+	if !e.IsSuitable("Acme", "Engineer", "1 year of experience", 0) {
+		t.Fatal("qualifications within MaxYearsExperience should pass")
+	}
+}
+
+func TestFilterEngineIsSuitableMaxAgeDays(t *testing.T) {
+	e := engineWithConfig(FilterConfig{Company: "Acme", MaxAgeDays: 30})
+
+	if e.IsSuitable("Acme", "Engineer", "", 31) {
+		t.Fatal("a posting older than MaxAgeDays should be rejected")
+	}
+	if !e.IsSuitable("Acme", "Engineer", "", 30) {
+		t.Fatal("a posting at exactly MaxAgeDays should pass")
+	}
+}
+
+func TestFilterEngineIsSuitableRequiredKeywords(t *testing.T) {
+	e := engineWithConfig(FilterConfig{Company: "Acme", RequiredKeywords: []string{"Go"}})
+
+	if e.IsSuitable("Acme", "Engineer", "Python experience", 0) {
+		t.Fatal("a posting missing every required keyword should be rejected")
+	}
+	if !e.IsSuitable("Acme", "Go Engineer", "", 0) {
+		t.Fatal("a posting with a required keyword in the title should pass")
+	}
+}
+
+func TestFilterEngineScore(t *testing.T) {
+	e := engineWithConfig(FilterConfig{Company: "Acme", RequiredKeywords: []string{"Go", "gRPC"}})
+
+	if score := e.Score("Acme", "Go Engineer", "gRPC experience"); score != 3 {
+		t.Fatalf("expected title match (2) + qualifications match (1) = 3, got %d", score)
+	}
+	if score := e.Score("Unconfigured", "Go Engineer", "gRPC"); score != 0 {
+		t.Fatalf("an unconfigured company should always score 0, got %d", score)
+	}
+}
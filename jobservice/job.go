@@ -0,0 +1,23 @@
+package jobservice
+
+import "context"
+
+// Job represents a single job posting aggregated from one of the sources.
+type Job struct {
+	Company                 string
+	Title                   string
+	Qualifications          []string
+	PreferredQualifications []string
+	Description             string
+	URL                     string
+	// Score ranks relevance as produced by a FilterEngine; higher is better.
+	Score int
+}
+
+// JobSearch is implemented by each job source (AmazonSearch, LeagueSearch,
+// ShopifySearch, ...) and returns the postings that source currently has
+// after applying its own filters. It returns an error rather than crashing
+// the process so one unhealthy source can't take down the others.
+type JobSearch interface {
+	Jobs(ctx context.Context) ([]Job, error)
+}
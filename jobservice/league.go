@@ -0,0 +1,132 @@
+package jobservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LeagueSearch holds the search URL for League's Lever-hosted postings.
+type LeagueSearch struct {
+	SearchURL string
+	Filter    *FilterEngine
+}
+
+// NewLeagueSearch returns the default LeagueSearch, filtering and scoring
+// postings using filter's "League" FilterConfig, if one is configured.
+func NewLeagueSearch(filter *FilterEngine) *LeagueSearch {
+	return &LeagueSearch{
+		SearchURL: "https://api.lever.co/v0/postings/league?mode=json",
+		Filter:    filter,
+	}
+}
+
+// leaguePosting holds a subset of the fields Lever returns per posting.
+type leaguePosting struct {
+	Title       string `json:"text"`
+	HostedURL   string `json:"hostedUrl"`
+	CreatedAt   int64  `json:"createdAt"`
+	Description string `json:"descriptionPlain"`
+	Lists       []struct {
+		Text    string `json:"text"`
+		Content string `json:"content"`
+	} `json:"lists"`
+}
+
+// Jobs calls League's Lever-hosted postings API and applies the configured
+// FilterEngine to show only relevant job postings. It returns an error,
+// rather than crashing the process, if Lever returns malformed JSON or a
+// status of 400 or above.
+func (l *LeagueSearch) Jobs(ctx context.Context) ([]Job, error) {
+	var jobArray []Job
+	responseBody, err := l.callAPI(ctx, l.SearchURL)
+	if err != nil {
+		return nil, err
+	}
+	postings, err := l.convertToPostings(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range postings {
+		qualifications := l.qualificationsFrom(p)
+		qualificationsText := strings.Join(qualifications, " ")
+		if !l.isSuitable(p, qualificationsText) {
+			continue
+		}
+
+		jobArray = append(jobArray, Job{
+			Company:        "League",
+			Title:          p.Title,
+			Qualifications: qualifications,
+			Description:    p.Description,
+			URL:            p.HostedURL,
+			Score:          l.Filter.Score("League", p.Title, qualificationsText),
+		})
+	}
+	return jobArray, nil
+}
+
+func (l *LeagueSearch) callAPI(ctx context.Context, url string) ([]byte, error) {
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("league: build request for %s: %w", url, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Printf("source=League url=%s elapsed=%s error=%v", url, time.Since(start), err)
+		return nil, fmt.Errorf("league: call %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	log.Printf("source=League url=%s status=%d elapsed=%s", url, resp.StatusCode, time.Since(start))
+	if err != nil {
+		return nil, fmt.Errorf("league: read response from %s: %w", url, err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("league: %s returned status %d", url, resp.StatusCode)
+	}
+	return body, nil
+}
+
+func (l *LeagueSearch) convertToPostings(bytes []byte) ([]leaguePosting, error) {
+	if !json.Valid(bytes) {
+		return nil, fmt.Errorf("league: response body is not valid JSON")
+	}
+	var postings []leaguePosting
+	if err := json.Unmarshal(bytes, &postings); err != nil {
+		return nil, fmt.Errorf("league: unmarshal postings: %w", err)
+	}
+	return postings, nil
+}
+
+func (l *LeagueSearch) isSuitable(p leaguePosting, qualificationsText string) bool {
+	return l.Filter.IsSuitable("League", p.Title, qualificationsText, ageDaysSinceUnixMillis(p.CreatedAt))
+}
+
+// qualificationsFrom pulls the "Qualifications"-labelled section out of
+// Lever's free-form list blocks, stripping the HTML list markup around each entry.
+func (l *LeagueSearch) qualificationsFrom(p leaguePosting) []string {
+	var result []string
+	r := strings.NewReplacer("<li>", "", "</li>", "\n", "<ul>", "", "</ul>", "")
+	for _, section := range p.Lists {
+		if !strings.Contains(strings.ToLower(section.Text), "qualification") {
+			continue
+		}
+		for _, line := range strings.Split(r.Replace(section.Content), "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" {
+				result = append(result, line)
+			}
+		}
+	}
+	return result
+}
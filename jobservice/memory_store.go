@@ -0,0 +1,136 @@
+package jobservice
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// record pairs a Job with the bookkeeping MemoryStore needs to answer
+// Since/Search/Deleted queries without a real database.
+type record struct {
+	job       Job
+	firstSeen time.Time
+	lastSeen  time.Time
+	deleted   bool
+	deletedAt time.Time
+}
+
+// MemoryStore is an in-memory Store. It's useful for local development and
+// tests, but history is lost on restart.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	records map[string]record
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]record)}
+}
+
+func storeKey(j Job) string {
+	return j.Company + "|" + j.URL
+}
+
+// Upsert implements Store. Any existing record whose company appears in jobs
+// but whose key doesn't is flagged deleted rather than dropped, so Deleted
+// can later report it.
+func (m *MemoryStore) Upsert(ctx context.Context, jobs []Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	seenKeys := make(map[string]bool, len(jobs))
+	companies := make(map[string]bool)
+	for _, j := range jobs {
+		seenKeys[storeKey(j)] = true
+		companies[j.Company] = true
+	}
+
+	for key, r := range m.records {
+		if !r.deleted && companies[r.job.Company] && !seenKeys[key] {
+			r.deleted = true
+			r.deletedAt = now
+			m.records[key] = r
+		}
+	}
+
+	for _, j := range jobs {
+		key := storeKey(j)
+		firstSeen := now
+		if existing, ok := m.records[key]; ok {
+			firstSeen = existing.firstSeen
+		}
+		m.records[key] = record{job: j, firstSeen: firstSeen, lastSeen: now}
+	}
+	return nil
+}
+
+// Search implements Store.
+func (m *MemoryStore) Search(ctx context.Context, q Query) ([]Job, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []Job
+	for _, r := range m.records {
+		if !r.deleted && matchesQuery(r, q) {
+			results = append(results, r.job)
+		}
+	}
+	return results, nil
+}
+
+// Since implements Store.
+func (m *MemoryStore) Since(ctx context.Context, t time.Time) ([]Job, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []Job
+	for _, r := range m.records {
+		if !r.deleted && !r.lastSeen.Before(t) {
+			results = append(results, r.job)
+		}
+	}
+	return results, nil
+}
+
+// Deleted implements Store.
+func (m *MemoryStore) Deleted(ctx context.Context, t time.Time) ([]Job, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []Job
+	for _, r := range m.records {
+		if r.deleted && !r.deletedAt.Before(t) {
+			results = append(results, r.job)
+		}
+	}
+	return results, nil
+}
+
+func matchesQuery(r record, q Query) bool {
+	if q.Company != "" && !strings.EqualFold(r.job.Company, q.Company) {
+		return false
+	}
+	if q.Keyword != "" && !strings.Contains(strings.ToLower(r.job.Title), strings.ToLower(q.Keyword)) {
+		return false
+	}
+	if !q.PostedAfter.IsZero() && r.firstSeen.Before(q.PostedAfter) {
+		return false
+	}
+	if q.QualificationsContains != "" && !qualificationsContain(r.job, q.QualificationsContains) {
+		return false
+	}
+	return true
+}
+
+func qualificationsContain(j Job, needle string) bool {
+	needle = strings.ToLower(needle)
+	for _, q := range append(append([]string{}, j.Qualifications...), j.PreferredQualifications...) {
+		if strings.Contains(strings.ToLower(q), needle) {
+			return true
+		}
+	}
+	return false
+}
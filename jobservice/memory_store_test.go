@@ -0,0 +1,118 @@
+package jobservice
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreUpsertAddsAndUpdates(t *testing.T) {
+	m := NewMemoryStore()
+	ctx := context.Background()
+
+	if err := m.Upsert(ctx, []Job{{Company: "Acme", Title: "Engineer", URL: "/1"}}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	results, err := m.Search(ctx, Query{Company: "Acme"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results) != 1 || results[0].Title != "Engineer" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+
+	if err := m.Upsert(ctx, []Job{{Company: "Acme", Title: "Senior Engineer", URL: "/1"}}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	results, err = m.Search(ctx, Query{Company: "Acme"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results) != 1 || results[0].Title != "Senior Engineer" {
+		t.Fatalf("expected the record to be updated in place, got: %+v", results)
+	}
+}
+
+func TestMemoryStoreUpsertMarksMissingAsDeleted(t *testing.T) {
+	m := NewMemoryStore()
+	ctx := context.Background()
+
+	if err := m.Upsert(ctx, []Job{
+		{Company: "Acme", Title: "Engineer", URL: "/1"},
+		{Company: "Acme", Title: "Designer", URL: "/2"},
+	}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	before := time.Now()
+	if err := m.Upsert(ctx, []Job{{Company: "Acme", Title: "Engineer", URL: "/1"}}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	results, err := m.Search(ctx, Query{Company: "Acme"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results) != 1 || results[0].URL != "/1" {
+		t.Fatalf("expected the dropped posting to no longer appear in Search, got: %+v", results)
+	}
+
+	deleted, err := m.Deleted(ctx, before)
+	if err != nil {
+		t.Fatalf("Deleted: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0].URL != "/2" {
+		t.Fatalf("expected the dropped posting to be reported deleted, got: %+v", deleted)
+	}
+}
+
+func TestMemoryStoreUpsertLeavesOtherCompaniesAlone(t *testing.T) {
+	m := NewMemoryStore()
+	ctx := context.Background()
+
+	if err := m.Upsert(ctx, []Job{
+		{Company: "Acme", Title: "Engineer", URL: "/1"},
+		{Company: "Globex", Title: "Analyst", URL: "/2"},
+	}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	if err := m.Upsert(ctx, []Job{{Company: "Acme", Title: "Engineer", URL: "/1"}}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	results, err := m.Search(ctx, Query{Company: "Globex"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected Globex's posting to be untouched by an Acme-only refresh, got: %+v", results)
+	}
+}
+
+func TestMemoryStoreSince(t *testing.T) {
+	m := NewMemoryStore()
+	ctx := context.Background()
+	cutoff := time.Now()
+
+	if err := m.Upsert(ctx, []Job{{Company: "Acme", Title: "Engineer", URL: "/1"}}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	results, err := m.Since(ctx, cutoff)
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected the just-upserted job to satisfy Since(cutoff), got: %+v", results)
+	}
+
+	results, err = m.Since(ctx, cutoff.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no jobs seen after cutoff+1h, got: %+v", results)
+	}
+}
@@ -0,0 +1,137 @@
+package jobservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ShopifySearch holds the search URL for Shopify's Greenhouse-hosted postings.
+type ShopifySearch struct {
+	SearchURL string
+	Filter    *FilterEngine
+}
+
+// NewShopifySearch returns the default ShopifySearch, filtering and scoring
+// postings using filter's "Shopify" FilterConfig, if one is configured.
+func NewShopifySearch(filter *FilterEngine) *ShopifySearch {
+	return &ShopifySearch{
+		SearchURL: "https://boards-api.greenhouse.io/v1/boards/shopify/jobs?content=true",
+		Filter:    filter,
+	}
+}
+
+// shopifyJob holds a subset of the fields Greenhouse returns per posting.
+type shopifyJob struct {
+	Title     string `json:"title"`
+	URL       string `json:"absolute_url"`
+	UpdatedAt string `json:"updated_at"`
+	Content   string `json:"content"`
+}
+
+// shopifyJobList represents the highest level struct returned by Greenhouse.
+type shopifyJobList struct {
+	Jobs []shopifyJob `json:"jobs"`
+}
+
+var shopifyTagPattern = regexp.MustCompile(`<[^>]+>`)
+
+// Jobs calls Shopify's Greenhouse-hosted postings API and applies the
+// configured FilterEngine to show only relevant job postings. It returns an
+// error, rather than crashing the process, if Greenhouse returns malformed
+// JSON or a status of 400 or above.
+func (s *ShopifySearch) Jobs(ctx context.Context) ([]Job, error) {
+	var jobArray []Job
+	responseBody, err := s.callAPI(ctx, s.SearchURL)
+	if err != nil {
+		return nil, err
+	}
+	jobList, err := s.convertToJSONList(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range jobList.Jobs {
+		qualifications := s.processContent(v.Content)
+		qualificationsText := strings.Join(qualifications, " ")
+		ageDays := s.ageDaysSince(v.UpdatedAt)
+		if !s.Filter.IsSuitable("Shopify", v.Title, qualificationsText, ageDays) {
+			continue
+		}
+
+		jobArray = append(jobArray, Job{
+			Company:        "Shopify",
+			Title:          v.Title,
+			Qualifications: qualifications,
+			URL:            v.URL,
+			Score:          s.Filter.Score("Shopify", v.Title, qualificationsText),
+		})
+	}
+	return jobArray, nil
+}
+
+func (s *ShopifySearch) callAPI(ctx context.Context, url string) ([]byte, error) {
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("shopify: build request for %s: %w", url, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Printf("source=Shopify url=%s elapsed=%s error=%v", url, time.Since(start), err)
+		return nil, fmt.Errorf("shopify: call %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	log.Printf("source=Shopify url=%s status=%d elapsed=%s", url, resp.StatusCode, time.Since(start))
+	if err != nil {
+		return nil, fmt.Errorf("shopify: read response from %s: %w", url, err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("shopify: %s returned status %d", url, resp.StatusCode)
+	}
+	return body, nil
+}
+
+func (s *ShopifySearch) convertToJSONList(bytes []byte) (shopifyJobList, error) {
+	if !json.Valid(bytes) {
+		return shopifyJobList{}, fmt.Errorf("shopify: response body is not valid JSON")
+	}
+	var jobList shopifyJobList
+	if err := json.Unmarshal(bytes, &jobList); err != nil {
+		return shopifyJobList{}, fmt.Errorf("shopify: unmarshal job list: %w", err)
+	}
+	return jobList, nil
+}
+
+// ageDaysSince converts Greenhouse's RFC3339 "updated_at" timestamp into an
+// approximate age in days, treating an unparseable timestamp as brand new
+// rather than rejecting the posting outright.
+func (s *ShopifySearch) ageDaysSince(updatedAt string) int {
+	t, err := time.Parse(time.RFC3339, updatedAt)
+	if err != nil {
+		return 0
+	}
+	return int(time.Since(t).Hours() / 24)
+}
+
+// processContent strips Greenhouse's HTML job description down to plain,
+// non-empty lines, mirroring AmazonSearch.processQualifications.
+func (s *ShopifySearch) processContent(html string) []string {
+	var result []string
+	for _, line := range strings.Split(shopifyTagPattern.ReplaceAllString(html, "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
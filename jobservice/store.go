@@ -0,0 +1,31 @@
+package jobservice
+
+import (
+	"context"
+	"time"
+)
+
+// Query describes the criteria used by Store.Search to filter the job history.
+// Zero-valued fields are ignored.
+type Query struct {
+	Keyword                string    `json:"keyword"`
+	Company                string    `json:"company"`
+	PostedAfter            time.Time `json:"postedAfter"`
+	QualificationsContains string    `json:"qualificationsContains"`
+}
+
+// Store persists Jobs across runs so that history can be retained, deleted
+// postings can be detected, and past results can be searched.
+type Store interface {
+	// Upsert inserts or updates jobs, keyed by Company+URL. For each company
+	// represented in jobs, any previously stored job for that company that's
+	// absent from jobs is flagged as deleted rather than removed outright.
+	Upsert(ctx context.Context, jobs []Job) error
+	// Search returns the stored, non-deleted jobs matching q.
+	Search(ctx context.Context, q Query) ([]Job, error)
+	// Since returns the non-deleted jobs that were last upserted at or after t.
+	Since(ctx context.Context, t time.Time) ([]Job, error)
+	// Deleted returns jobs flagged as removed (absent from their company's
+	// most recent Upsert) at or after t.
+	Deleted(ctx context.Context, t time.Time) ([]Job, error)
+}
@@ -0,0 +1,13 @@
+package jobservice
+
+import "time"
+
+// ageDaysSinceUnixMillis converts a Unix millisecond timestamp into an
+// approximate age in days, for sources that report postings as epoch time
+// rather than a relative string like Amazon's "2 months ago".
+func ageDaysSinceUnixMillis(ms int64) int {
+	if ms == 0 {
+		return 0
+	}
+	return int(time.Since(time.UnixMilli(ms)).Hours() / 24)
+}
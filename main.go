@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/kisunji/jobsearchaggregator/jobs"
 	"github.com/kisunji/jobsearchaggregator/jobservice"
+	"golang.org/x/sync/singleflight"
 )
 
 var (
@@ -18,7 +24,133 @@ var (
 	ErrJobService = errors.New("There was an issue with the jobservice API")
 )
 
+// defaultCacheTTL is used when the CACHE_TTL env var is unset or invalid.
+const defaultCacheTTL = 5 * time.Minute
+
+// jobsCacheKey is the singleflight/cache key for getJobs. There's only ever
+// one aggregate payload, so a constant key is enough to dedupe concurrent
+// refreshes that share the same forceRefresh value; see jobsGroupKey.
+const jobsCacheKey = "getJobs"
+
+// jobsGroupKey partitions jobsGroup by forceRefresh so a forced request can
+// never silently piggyback on an in-flight non-forced fetch's (stale) result.
+func jobsGroupKey(forceRefresh bool) string {
+	if forceRefresh {
+		return jobsCacheKey + ":force"
+	}
+	return jobsCacheKey
+}
+
+var jobsGroup singleflight.Group
+
+// jobsCache holds the most recently marshaled getJobs payload so that
+// requests within the TTL window reuse it instead of re-marshaling the
+// scheduler's snapshot.
+var jobsCache = struct {
+	mu        sync.RWMutex
+	payload   []byte
+	fetchedAt time.Time
+}{}
+
+func cacheTTL() time.Duration {
+	if s, ok := os.LookupEnv("CACHE_TTL"); ok {
+		if d, err := time.ParseDuration(s); err == nil {
+			return d
+		}
+		log.Printf("Invalid CACHE_TTL %q, falling back to default %s", s, defaultCacheTTL)
+	}
+	return defaultCacheTTL
+}
+
+// store persists every job getJobs sees so that history can be retained and
+// queried later, even once a posting is no longer returned by its source.
+// It defaults to an in-memory store; set ES_URL to back it with Elasticsearch.
+var store jobservice.Store = jobservice.NewMemoryStore()
+
+func init() {
+	url, ok := os.LookupEnv("ES_URL")
+	if !ok {
+		return
+	}
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{url}})
+	if err != nil {
+		log.Fatalf("Failed to create Elasticsearch client for %s: %v", url, err)
+	}
+	store = jobservice.NewESStore(client)
+	log.Printf("Using Elasticsearch store at %s", url)
+}
+
+// filterEngine holds the per-company relevance rules each JobSearch consults
+// so users can tune what's suitable without recompiling. It's loaded from
+// FILTER_CONFIG_PATH, or filters.json if that's unset, and falls back to an
+// empty, unfiltering engine if the file can't be read or parsed.
+var filterEngine = loadFilterEngine()
+
+func loadFilterEngine() *jobservice.FilterEngine {
+	path, ok := os.LookupEnv("FILTER_CONFIG_PATH")
+	if !ok {
+		path = "filters.json"
+	}
+	engine, err := jobservice.LoadFilterEngine(path)
+	if err != nil {
+		log.Printf("Falling back to an unfiltered FilterEngine: %v", err)
+		return jobservice.NewFilterEngine()
+	}
+	return engine
+}
+
+// defaultScrapeConcurrency bounds how many sources the scheduler runs at once.
+const defaultScrapeConcurrency = 2
+
+// scheduler runs each JobSearch on its own cadence in the background so
+// requests are served from the last-successful snapshot instead of blocking
+// on a live scrape.
+var scheduler = newScheduler()
+
+func newScheduler() *jobs.Scheduler {
+	sources := []jobs.Source{
+		{Name: "Amazon", Search: jobservice.NewAmazonSearch(filterEngine), Interval: 15 * time.Minute},
+		{Name: "League", Search: jobservice.NewLeagueSearch(filterEngine), Interval: 30 * time.Minute},
+		{Name: "Shopify", Search: jobservice.NewShopifySearch(filterEngine), Interval: time.Hour},
+	}
+
+	concurrency := defaultScrapeConcurrency
+	if s, ok := os.LookupEnv("SCRAPE_CONCURRENCY"); ok {
+		if n, err := strconv.Atoi(s); err == nil && n > 0 {
+			concurrency = n
+		}
+	}
+
+	scrapeTimeout := jobs.DefaultScrapeTimeout
+	if s, ok := os.LookupEnv("SCRAPE_TIMEOUT"); ok {
+		if d, err := time.ParseDuration(s); err == nil {
+			scrapeTimeout = d
+		}
+	}
+
+	return jobs.NewScheduler(sources, concurrency, scrapeTimeout)
+}
+
+// aggregator is the shared entry point for fetching the aggregate job list,
+// used by both this HTTP/Lambda binary and cmd/grpcserver.
+var aggregator = jobservice.NewAggregator(scheduler, store)
+
+// defaultHandlerTimeout bounds how long a request may wait on a forced
+// refresh before giving up, overridable via the HANDLER_TIMEOUT env var.
+const defaultHandlerTimeout = 10 * time.Second
+
+func handlerTimeout() time.Duration {
+	if s, ok := os.LookupEnv("HANDLER_TIMEOUT"); ok {
+		if d, err := time.ParseDuration(s); err == nil {
+			return d
+		}
+	}
+	return defaultHandlerTimeout
+}
+
 func main() {
+	scheduler.Start(context.Background())
+
 	mode := os.Getenv("MODE")
 	switch mode {
 	case "lambda":
@@ -33,26 +165,54 @@ func main() {
 			port = ":" + port
 		}
 		http.HandleFunc("/JobSearch", LocalHandler)
+		http.HandleFunc("/JobSearch/query", LocalQueryHandler)
+		http.HandleFunc("/status", LocalStatusHandler)
 		log.Printf("Running locally: localhost%s/JobSearch", port)
 		http.ListenAndServe(port, nil)
 	}
 }
 
 //Handler is the AWS Lambda function handler that uses Amazon API Gateway request/response
-func Handler(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+func Handler(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	log.Printf("Processing Lambda request %s\n", request.RequestContext.RequestID)
-	bytes, err := getJobs()
-	if err != nil {
-		return events.APIGatewayProxyResponse{}, ErrJobService
-	}
+	ctx, cancel := context.WithTimeout(ctx, handlerTimeout())
+	defer cancel()
+
 	origin, ok := os.LookupEnv("CORS")
 	if !ok {
 		origin = "*"
 	}
+	headers := map[string]string{"Access-Control-Allow-Origin": origin}
+
+	if request.Path == "/JobSearch/query" {
+		var q jobservice.Query
+		if err := json.Unmarshal([]byte(request.Body), &q); err != nil {
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusBadRequest, Headers: headers}, nil
+		}
+		bytes, err := queryJobs(ctx, q)
+		if err != nil {
+			return events.APIGatewayProxyResponse{}, ErrJobService
+		}
+		return events.APIGatewayProxyResponse{StatusCode: 200, Body: string(bytes), Headers: headers}, nil
+	}
+
+	if request.Path == "/status" {
+		bytes, err := json.Marshal(scheduler.Statuses())
+		if err != nil {
+			return events.APIGatewayProxyResponse{}, ErrJobService
+		}
+		return events.APIGatewayProxyResponse{StatusCode: 200, Body: string(bytes), Headers: headers}, nil
+	}
+
+	forceRefresh := request.QueryStringParameters["refresh"] == "1" || request.Headers["FORCE_REFRESH"] != ""
+	bytes, err := getJobs(ctx, forceRefresh)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, ErrJobService
+	}
 	return events.APIGatewayProxyResponse{
 		StatusCode: 200,
 		Body:       string(bytes),
-		Headers:    map[string]string{"Access-Control-Allow-Origin": origin},
+		Headers:    headers,
 	}, nil
 }
 
@@ -63,7 +223,33 @@ func LocalHandler(w http.ResponseWriter, req *http.Request) {
 		origin = "*"
 	}
 	w.Header().Set("Access-Control-Allow-Origin", origin)
-	bytes, err := getJobs()
+	ctx, cancel := context.WithTimeout(req.Context(), handlerTimeout())
+	defer cancel()
+
+	forceRefresh := req.URL.Query().Get("refresh") == "1" || req.Header.Get("FORCE_REFRESH") != ""
+	bytes, err := getJobs(ctx, forceRefresh)
+	if err != nil {
+		http.Error(w, "Error occurred", http.StatusInternalServerError)
+		return
+	}
+	w.Write(bytes)
+}
+
+// LocalQueryHandler handles POST /JobSearch/query for local testing, returning
+// jobs from the store matching the JSON-encoded jobservice.Query in the body.
+func LocalQueryHandler(w http.ResponseWriter, req *http.Request) {
+	origin, ok := os.LookupEnv("CORS")
+	if !ok {
+		origin = "*"
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+
+	var q jobservice.Query
+	if err := json.NewDecoder(req.Body).Decode(&q); err != nil {
+		http.Error(w, "Invalid query", http.StatusBadRequest)
+		return
+	}
+	bytes, err := queryJobs(req.Context(), q)
 	if err != nil {
 		http.Error(w, "Error occurred", http.StatusInternalServerError)
 		return
@@ -71,26 +257,76 @@ func LocalHandler(w http.ResponseWriter, req *http.Request) {
 	w.Write(bytes)
 }
 
-func getJobs() ([]byte, error) {
-	defer timeTrack(time.Now(), "getJobs")
+// LocalStatusHandler handles GET /status for local testing, returning the
+// scheduler's per-source status (PENDING/RUNNING/COMPLETE/ERROR) as JSON.
+func LocalStatusHandler(w http.ResponseWriter, req *http.Request) {
+	origin, ok := os.LookupEnv("CORS")
+	if !ok {
+		origin = "*"
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
 
-	services := []jobservice.JobSearch{
-		jobservice.NewAmazonSearch(),
-		jobservice.NewLeagueSearch(),
-		jobservice.NewShopifySearch(),
+	bytes, err := json.Marshal(scheduler.Statuses())
+	if err != nil {
+		http.Error(w, "Error occurred", http.StatusInternalServerError)
+		return
 	}
+	w.Write(bytes)
+}
 
-	c := make(chan []jobservice.Job)
-	for _, v := range services {
-		go func(v jobservice.JobSearch) { c <- v.Jobs() }(v)
+// queryJobs searches the store for jobs matching q and returns them marshaled as JSON.
+func queryJobs(ctx context.Context, q jobservice.Query) ([]byte, error) {
+	results, err := store.Search(ctx, q)
+	if err != nil {
+		return nil, err
 	}
-	var jobArray []jobservice.Job
-	for i := 0; i < len(services); i++ {
-		result := <-c
-		jobArray = append(jobArray, result...)
+	return json.Marshal(results)
+}
+
+// getJobs returns the marshaled aggregate job list from the scheduler's
+// last-successful snapshot, serving a cached payload when one exists and is
+// younger than cacheTTL so repeated requests don't re-marshal on every call.
+// forceRefresh bypasses the cache and blocks until the scheduler has run
+// every source once more (skipping any whose circuit is open) or ctx is
+// done, whichever comes first. Concurrent callers that miss the cache
+// share a single marshal via jobsGroup, keyed separately per forceRefresh
+// value, so stampeding requests don't each trigger their own round of
+// refreshes, and a forced request can never piggyback on a plain one.
+func getJobs(ctx context.Context, forceRefresh bool) ([]byte, error) {
+	if !forceRefresh {
+		jobsCache.mu.RLock()
+		payload, fetchedAt := jobsCache.payload, jobsCache.fetchedAt
+		jobsCache.mu.RUnlock()
+		if payload != nil && time.Since(fetchedAt) < cacheTTL() {
+			return payload, nil
+		}
+	}
+
+	v, err, _ := jobsGroup.Do(jobsGroupKey(forceRefresh), func() (interface{}, error) {
+		return fetchJobs(ctx, forceRefresh)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return v.([]byte), nil
+}
+
+func fetchJobs(ctx context.Context, forceRefresh bool) ([]byte, error) {
+	defer timeTrack(time.Now(), "fetchJobs")
+
+	jobArray := aggregator.Jobs(ctx, forceRefresh)
 	log.Printf("Jobs found: %d", len(jobArray))
-	return json.Marshal(jobArray)
+
+	payload, err := json.Marshal(jobArray)
+	if err != nil {
+		return nil, err
+	}
+
+	jobsCache.mu.Lock()
+	jobsCache.payload, jobsCache.fetchedAt = payload, time.Now()
+	jobsCache.mu.Unlock()
+
+	return payload, nil
 }
 
 // timeTrack measures time to execute
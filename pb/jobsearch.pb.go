@@ -0,0 +1,107 @@
+// Hand-written to mirror the shape protoc-gen-go would produce from
+// proto/jobsearch.proto (field numbers match the .proto exactly), since this
+// repo has no protoc toolchain wired up yet. Each message implements the
+// legacy proto.Message interface (Reset/String/ProtoMessage) rather than the
+// newer ProtoReflect-based one, which google.golang.org/protobuf's runtime
+// (and therefore grpc's default "proto" codec) still accepts via its
+// struct-tag-driven legacy support. Regenerate with protoc once available.
+
+package pb
+
+import "fmt"
+
+// Job mirrors jobservice.Job for the wire. Field numbers correspond to the
+// ones declared in proto/jobsearch.proto.
+type Job struct {
+	Company                 string   `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Title                   string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Qualifications          []string `protobuf:"bytes,3,rep,name=qualifications,proto3" json:"qualifications,omitempty"`
+	PreferredQualifications []string `protobuf:"bytes,4,rep,name=preferred_qualifications,json=preferredQualifications,proto3" json:"preferred_qualifications,omitempty"`
+	Description             string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Url                     string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Score                   int32    `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Job) Reset()         { *x = Job{} }
+func (x *Job) String() string { return fmt.Sprintf("%+v", *x) }
+func (*Job) ProtoMessage()    {}
+
+// ListJobsRequest is the request for JobSearchService.ListJobs.
+type ListJobsRequest struct {
+	ForceRefresh bool `protobuf:"varint,1,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"`
+}
+
+func (x *ListJobsRequest) Reset()         { *x = ListJobsRequest{} }
+func (x *ListJobsRequest) String() string { return fmt.Sprintf("%+v", *x) }
+func (*ListJobsRequest) ProtoMessage()    {}
+
+// ListJobsResponse is the response for JobSearchService.ListJobs.
+type ListJobsResponse struct {
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset()         { *x = ListJobsResponse{} }
+func (x *ListJobsResponse) String() string { return fmt.Sprintf("%+v", *x) }
+func (*ListJobsResponse) ProtoMessage()    {}
+
+// StreamJobsRequest is the request for JobSearchService.StreamJobs.
+type StreamJobsRequest struct {
+	ForceRefresh bool `protobuf:"varint,1,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"`
+}
+
+func (x *StreamJobsRequest) Reset()         { *x = StreamJobsRequest{} }
+func (x *StreamJobsRequest) String() string { return fmt.Sprintf("%+v", *x) }
+func (*StreamJobsRequest) ProtoMessage()    {}
+
+// JobBatch groups the jobs produced by a single source's run, sent as soon
+// as that source completes.
+type JobBatch struct {
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Jobs   []*Job `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *JobBatch) Reset()         { *x = JobBatch{} }
+func (x *JobBatch) String() string { return fmt.Sprintf("%+v", *x) }
+func (*JobBatch) ProtoMessage()    {}
+
+// GetJobRequest is the request for JobSearchService.GetJob.
+type GetJobRequest struct {
+	Company string `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Url     string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *GetJobRequest) Reset()         { *x = GetJobRequest{} }
+func (x *GetJobRequest) String() string { return fmt.Sprintf("%+v", *x) }
+func (*GetJobRequest) ProtoMessage()    {}
+
+// GetForceRefresh is a nil-safe accessor, as protoc-gen-go generates for every field.
+func (r *ListJobsRequest) GetForceRefresh() bool {
+	if r == nil {
+		return false
+	}
+	return r.ForceRefresh
+}
+
+// GetForceRefresh is a nil-safe accessor, as protoc-gen-go generates for every field.
+func (r *StreamJobsRequest) GetForceRefresh() bool {
+	if r == nil {
+		return false
+	}
+	return r.ForceRefresh
+}
+
+// GetCompany is a nil-safe accessor, as protoc-gen-go generates for every field.
+func (r *GetJobRequest) GetCompany() string {
+	if r == nil {
+		return ""
+	}
+	return r.Company
+}
+
+// GetUrl is a nil-safe accessor, as protoc-gen-go generates for every field.
+func (r *GetJobRequest) GetUrl() string {
+	if r == nil {
+		return ""
+	}
+	return r.Url
+}
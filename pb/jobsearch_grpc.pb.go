@@ -0,0 +1,176 @@
+// Hand-written to mirror the shape protoc-gen-go-grpc would produce from
+// proto/jobsearch.proto, since this repo has no protoc toolchain wired up
+// yet. Regenerate with protoc once available.
+
+package pb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JobSearchServiceClient is the client API for JobSearchService.
+type JobSearchServiceClient interface {
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	StreamJobs(ctx context.Context, in *StreamJobsRequest, opts ...grpc.CallOption) (JobSearchService_StreamJobsClient, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
+}
+
+type jobSearchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewJobSearchServiceClient returns a client for JobSearchService backed by cc.
+func NewJobSearchServiceClient(cc grpc.ClientConnInterface) JobSearchServiceClient {
+	return &jobSearchServiceClient{cc}
+}
+
+func (c *jobSearchServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	if err := c.cc.Invoke(ctx, "/jobsearch.JobSearchService/ListJobs", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSearchServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	if err := c.cc.Invoke(ctx, "/jobsearch.JobSearchService/GetJob", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSearchServiceClient) StreamJobs(ctx context.Context, in *StreamJobsRequest, opts ...grpc.CallOption) (JobSearchService_StreamJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JobSearchService_serviceDesc.Streams[0], "/jobsearch.JobSearchService/StreamJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobSearchServiceStreamJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// JobSearchService_StreamJobsClient is returned by JobSearchServiceClient.StreamJobs.
+type JobSearchService_StreamJobsClient interface {
+	Recv() (*JobBatch, error)
+	grpc.ClientStream
+}
+
+type jobSearchServiceStreamJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobSearchServiceStreamJobsClient) Recv() (*JobBatch, error) {
+	m := new(JobBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// JobSearchServiceServer is the server API for JobSearchService.
+type JobSearchServiceServer interface {
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	StreamJobs(*StreamJobsRequest, JobSearchService_StreamJobsServer) error
+	GetJob(context.Context, *GetJobRequest) (*Job, error)
+}
+
+// JobSearchService_StreamJobsServer is implemented by the server and used to
+// send JobBatch messages as each source finishes.
+type JobSearchService_StreamJobsServer interface {
+	Send(*JobBatch) error
+	grpc.ServerStream
+}
+
+type jobSearchServiceStreamJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobSearchServiceStreamJobsServer) Send(m *JobBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RegisterJobSearchServiceServer registers srv with s.
+func RegisterJobSearchServiceServer(s grpc.ServiceRegistrar, srv JobSearchServiceServer) {
+	s.RegisterService(&_JobSearchService_serviceDesc, srv)
+}
+
+func _JobSearchService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSearchServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/jobsearch.JobSearchService/ListJobs"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSearchServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSearchService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSearchServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/jobsearch.JobSearchService/GetJob"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSearchServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSearchService_StreamJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobSearchServiceServer).StreamJobs(m, &jobSearchServiceStreamJobsServer{stream})
+}
+
+// unimplementedJobSearchServiceServer can be embedded by servers that only
+// implement a subset of JobSearchServiceServer.
+type UnimplementedJobSearchServiceServer struct{}
+
+func (UnimplementedJobSearchServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+
+func (UnimplementedJobSearchServiceServer) StreamJobs(*StreamJobsRequest, JobSearchService_StreamJobsServer) error {
+	return status.Error(codes.Unimplemented, "method StreamJobs not implemented")
+}
+
+func (UnimplementedJobSearchServiceServer) GetJob(context.Context, *GetJobRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJob not implemented")
+}
+
+var _JobSearchService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "jobsearch.JobSearchService",
+	HandlerType: (*JobSearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "ListJobs", Handler: _JobSearchService_ListJobs_Handler},
+		{MethodName: "GetJob", Handler: _JobSearchService_GetJob_Handler},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamJobs",
+			Handler:       _JobSearchService_StreamJobs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/jobsearch.proto",
+}